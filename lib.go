@@ -1,3 +1,9 @@
+// Package redditsmm is a client for the redditsmm perfectpanel-style SMM
+// panel API. It is the original, pre-context v1 surface and is kept
+// byte-for-byte compatible for existing callers. New integrations should
+// import the v2 subpackage instead, which adds context.Context support,
+// typed errors, retries, request builders, fuller action coverage, and
+// numeric/status response types.
 package redditsmm
 
 import (
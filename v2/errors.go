@@ -0,0 +1,24 @@
+package redditsmm
+
+import "fmt"
+
+// APIError represents a failure response from the panel API. Panels in this
+// family report errors as a JSON object of the form {"error": "..."}, so
+// callers can match on Message instead of parsing a bare status code.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("redditsmm: api error (status %d): %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("redditsmm: request failed with status code %d", e.StatusCode)
+}
+
+// apiErrorBody is the shape of the JSON body the panel returns alongside a
+// non-2xx status code.
+type apiErrorBody struct {
+	Error string `json:"error"`
+}
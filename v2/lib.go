@@ -0,0 +1,364 @@
+// Package redditsmm is the v2 client for the redditsmm perfectpanel-style
+// SMM panel API. Every request carries a context.Context for cancellation
+// and deadlines, error responses from the panel are returned as *APIError,
+// and 429/5xx responses are
+// retried with backoff. This is a breaking change from the v1 package at the
+// repository root, which keeps its original signatures for existing callers;
+// new integrations should import this v2 package instead.
+package redditsmm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/ratelimit"
+)
+
+// maxRetries and baseRetryDelay bound the internal retry-with-backoff loop
+// used for 429 and 5xx responses.
+const (
+	maxRetries     = 3
+	baseRetryDelay = 500 * time.Millisecond
+)
+
+type Option func(option *options) error
+
+type options struct {
+	host       string
+	rateLimit  *ratelimit.Limiter
+	httpClient *http.Client
+}
+
+func WithHost(host string) Option {
+	return func(option *options) error {
+		// Check if host is valid.
+		_, err := http.NewRequest("GET", fmt.Sprintf("https://%s", host), nil)
+		if err != nil {
+			return fmt.Errorf("invalid host: %w", err)
+		}
+
+		option.host = host
+		return nil
+	}
+}
+
+func WithRateLimit(rl ratelimit.Limiter) Option {
+	return func(option *options) error {
+		option.rateLimit = &rl
+		return nil
+	}
+}
+
+func WithHttpClient(hc http.Client) Option {
+	return func(option *options) error {
+		option.httpClient = &hc
+		return nil
+	}
+}
+
+type Client struct {
+	apiKey  string
+	options *options
+}
+
+func New(apiKey string, opts ...Option) (*Client, error) {
+	o := &options{}
+	for _, opt := range opts {
+		err := opt(o)
+		if err != nil {
+			return nil, fmt.Errorf("bad option: %w", err)
+		}
+	}
+
+	if o.host == "" {
+		o.host = "redditsmm.com/api/v2"
+	}
+
+	if o.rateLimit == nil {
+		o.rateLimit = new(ratelimit.Limiter)
+		*o.rateLimit = ratelimit.New(10, ratelimit.Per(time.Second))
+	}
+
+	if o.httpClient == nil {
+		o.httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		apiKey:  apiKey,
+		options: o,
+	}, nil
+}
+
+func (c *Client) buildUrl() string {
+	return fmt.Sprintf("https://%s/", c.options.host)
+}
+
+// do sends req, retrying 429 and 5xx responses with backoff that honors any
+// Retry-After header as well as ctx's deadline. It returns an *APIError for
+// any response outside the 2xx range, except that it returns ctx.Err() when
+// ctx ends while it is waiting to retry.
+func (c *Client) do(ctx context.Context, req *http.Request) (data []byte, err error) {
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		(*c.options.rateLimit).Take()
+
+		res, err := c.options.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to send request: %w", err)
+		}
+
+		data, err = io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		if res.StatusCode >= 200 && res.StatusCode < 300 {
+			return data, nil
+		}
+
+		apiErr := &APIError{StatusCode: res.StatusCode}
+		var body apiErrorBody
+		if json.Unmarshal(data, &body) == nil {
+			apiErr.Message = body.Error
+		}
+		lastErr = apiErr
+
+		if !isRetryableStatus(res.StatusCode) || attempt == maxRetries {
+			return nil, apiErr
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryDelay(res.Header.Get("Retry-After"), attempt)):
+		}
+	}
+
+	return nil, lastErr
+}
+
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// retryDelay determines how long to wait before the next retry attempt,
+// preferring the panel's Retry-After header (either delay-seconds or an
+// HTTP-date) and falling back to exponential backoff.
+func retryDelay(retryAfter string, attempt int) time.Duration {
+	if retryAfter != "" {
+		if secs, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+		if t, err := http.ParseTime(retryAfter); err == nil {
+			if d := time.Until(t); d > 0 {
+				return d
+			}
+		}
+	}
+	return baseRetryDelay * time.Duration(uint(1)<<uint(attempt))
+}
+
+// post sends values as a form-urlencoded POST body, which is what this
+// family of panel APIs expects. It is the primitive the request builders in
+// requests.go are built on; the thin per-action methods below go through
+// those builders. Keeping the parameters in the body rather than the URL
+// also keeps the API key out of proxy and server access logs.
+func (c *Client) post(ctx context.Context, values url.Values) (data []byte, err error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.buildUrl(), strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return c.do(ctx, req)
+}
+
+// Response and data structures
+type Service struct {
+	Service  string `json:"service"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Category string `json:"category"`
+	Rate     Number `json:"rate"`
+	Min      Number `json:"min"`
+	Max      Number `json:"max"`
+}
+
+type OrderStatus struct {
+	Charge     Number `json:"charge"`
+	StartCount Number `json:"start_count"`
+	Status     Status `json:"status"`
+	Remains    Number `json:"remains"`
+	Currency   string `json:"currency"`
+}
+
+type UserBalance struct {
+	Balance  Number `json:"balance"`
+	Currency string `json:"currency"`
+}
+
+type RefillResponse struct {
+	Refill string `json:"refill"`
+}
+
+type RefillStatusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type CancelResponse struct {
+	Cancel string `json:"cancel"`
+	Error  string `json:"error,omitempty"`
+}
+
+// UserBalance is a thin wrapper around NewBalanceRequest for callers who
+// don't need the builder.
+func (c *Client) UserBalance(ctx context.Context) (UserBalance, error) {
+	return c.NewBalanceRequest().Do(ctx)
+}
+
+// Services is a thin wrapper around NewServicesRequest for callers who
+// don't need the builder.
+func (c *Client) Services(ctx context.Context) ([]Service, error) {
+	return c.NewServicesRequest().Do(ctx)
+}
+
+type addOrderOptions struct {
+	runs         *int
+	interval     *int
+	comments     []string
+	answerNumber *int
+	username     string
+}
+
+type AddOrderOption func(*addOrderOptions)
+
+func WithRuns(runs int) AddOrderOption {
+	return func(option *addOrderOptions) {
+		option.runs = &runs
+	}
+}
+
+func WithInterval(interval int) AddOrderOption {
+	return func(option *addOrderOptions) {
+		option.interval = &interval
+	}
+}
+
+// WithComments selects the "Custom Comments" order variant, sending one
+// comment per line to the panel.
+func WithComments(comments []string) AddOrderOption {
+	return func(option *addOrderOptions) {
+		option.comments = comments
+	}
+}
+
+// WithAnswerNumber selects the "Poll/Vote" order variant, choosing which
+// answer to vote for.
+func WithAnswerNumber(answerNumber int) AddOrderOption {
+	return func(option *addOrderOptions) {
+		option.answerNumber = &answerNumber
+	}
+}
+
+// WithUsername supplies the username parameter required by the "Poll/Vote"
+// order variant.
+func WithUsername(username string) AddOrderOption {
+	return func(option *addOrderOptions) {
+		option.username = username
+	}
+}
+
+// AddOrder is a thin wrapper around NewAddOrderRequest for callers who don't
+// need the builder.
+func (c *Client) AddOrder(ctx context.Context, serviceId, link string, quantity int, options ...AddOrderOption) (orderId string, err error) {
+	opts := &addOrderOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+
+	req := c.NewAddOrderRequest().Service(serviceId).Link(link).Quantity(quantity)
+	if opts.runs != nil {
+		req = req.Runs(*opts.runs)
+	}
+	if opts.interval != nil {
+		req = req.Interval(*opts.interval)
+	}
+	if opts.comments != nil {
+		req = req.Comments(opts.comments)
+	}
+	if opts.answerNumber != nil {
+		req = req.AnswerNumber(*opts.answerNumber)
+	}
+	if opts.username != "" {
+		req = req.Username(opts.username)
+	}
+
+	return req.Do(ctx)
+}
+
+// OrderStatus checks the status of a specific order by its integer ID. It is
+// a thin wrapper around NewStatusRequest for callers who don't need the
+// builder.
+func (c *Client) OrderStatus(ctx context.Context, orderId string) (OrderStatus, error) {
+	return c.NewStatusRequest().Order(orderId).Do(ctx)
+}
+
+// MultipleOrdersStatus checks the status of multiple orders given their
+// integer IDs. It is a thin wrapper around NewStatusRequest for callers who
+// don't need the builder.
+func (c *Client) MultipleOrdersStatus(ctx context.Context, orderIds []string) (map[string]OrderStatus, error) {
+	return c.NewStatusRequest().Orders(orderIds).DoMultiple(ctx)
+}
+
+// Refill requests a refill for a single order. It is a thin wrapper around
+// NewRefillRequest for callers who don't need the builder.
+func (c *Client) Refill(ctx context.Context, orderId string) (RefillResponse, error) {
+	return c.NewRefillRequest().Order(orderId).Do(ctx)
+}
+
+// MultipleRefill requests a refill for a batch of orders, keyed by order ID.
+// It is a thin wrapper around NewRefillRequest for callers who don't need
+// the builder.
+func (c *Client) MultipleRefill(ctx context.Context, orderIds []string) (map[string]RefillResponse, error) {
+	return c.NewRefillRequest().Orders(orderIds).DoMultiple(ctx)
+}
+
+// RefillStatus checks the status of a single refill by its integer ID. It is
+// a thin wrapper around NewRefillStatusRequest for callers who don't need
+// the builder.
+func (c *Client) RefillStatus(ctx context.Context, refillId string) (RefillStatusResponse, error) {
+	return c.NewRefillStatusRequest().Refill(refillId).Do(ctx)
+}
+
+// MultipleRefillStatus checks the status of a batch of refills, keyed by
+// refill ID. It is a thin wrapper around NewRefillStatusRequest for callers
+// who don't need the builder.
+func (c *Client) MultipleRefillStatus(ctx context.Context, refillIds []string) (map[string]RefillStatusResponse, error) {
+	return c.NewRefillStatusRequest().Refills(refillIds).DoMultiple(ctx)
+}
+
+// Cancel requests cancellation of a batch of orders, keyed by order ID. It
+// is a thin wrapper around NewCancelRequest for callers who don't need the
+// builder.
+func (c *Client) Cancel(ctx context.Context, orderIds []string) (map[string]CancelResponse, error) {
+	return c.NewCancelRequest().Orders(orderIds).Do(ctx)
+}
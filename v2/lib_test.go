@@ -0,0 +1,182 @@
+package redditsmm
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter string
+		attempt    int
+		want       time.Duration
+	}{
+		{"seconds", "2", 0, 2 * time.Second},
+		{"empty falls back to backoff attempt 0", "", 0, baseRetryDelay},
+		{"empty falls back to backoff attempt 1", "", 1, 2 * baseRetryDelay},
+		{"empty falls back to backoff attempt 2", "", 2, 4 * baseRetryDelay},
+		{"unparseable falls back to backoff", "soon", 0, baseRetryDelay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryDelay(tt.retryAfter, tt.attempt); got != tt.want {
+				t.Errorf("retryDelay(%q, %d) = %v, want %v", tt.retryAfter, tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryDelayHTTPDate(t *testing.T) {
+	future := time.Now().Add(3 * time.Second).UTC()
+	got := retryDelay(future.Format(http.TimeFormat), 0)
+	if got <= 0 || got > 4*time.Second {
+		t.Errorf("retryDelay with future HTTP-date = %v, want roughly 3s", got)
+	}
+}
+
+func TestIsRetryableStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		want   bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusBadGateway, true},
+		{http.StatusBadRequest, false},
+	}
+
+	for _, tt := range tests {
+		if got := isRetryableStatus(tt.status); got != tt.want {
+			t.Errorf("isRetryableStatus(%d) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+// newTestClient returns a Client wired up to talk to server over TLS,
+// trusting server's certificate.
+func newTestClient(t *testing.T, server *httptest.Server) *Client {
+	t.Helper()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+	c, err := New("test-key", WithHost(host), WithHttpClient(*server.Client()))
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return c
+}
+
+func TestDoRetriesOn429AndSucceeds(t *testing.T) {
+	var attempts int
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"balance":"12.5","currency":"USD"}`))
+	}))
+	defer server.Close()
+
+	balance, err := newTestClient(t, server).NewBalanceRequest().Do(context.Background())
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+	if balance.Balance != 12.5 {
+		t.Errorf("balance = %v, want 12.5", balance.Balance)
+	}
+}
+
+func TestDoReturnsAPIErrorAfterExhaustingRetries(t *testing.T) {
+	var attempts int
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"error":"panel is down"}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(t, server).NewBalanceRequest().Do(context.Background())
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("err = %T, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, http.StatusInternalServerError)
+	}
+	if apiErr.Message != "panel is down" {
+		t.Errorf("Message = %q, want %q", apiErr.Message, "panel is down")
+	}
+	if attempts != maxRetries+1 {
+		t.Errorf("attempts = %d, want %d", attempts, maxRetries+1)
+	}
+}
+
+func TestDoStopsRetryingWhenContextIsDone(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := newTestClient(t, server).NewBalanceRequest().Do(ctx)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if elapsed := time.Since(start); elapsed > baseRetryDelay {
+		t.Errorf("Do took %v, expected to stop well before a full backoff delay", elapsed)
+	}
+}
+
+func TestDoRewindsRequestBodyOnRetry(t *testing.T) {
+	var attempts int
+	var bodies []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("reading request body: %v", err)
+		}
+		bodies = append(bodies, string(buf))
+
+		if attempts < 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"order":"1"}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(t, server).NewAddOrderRequest().Service("1").Link("https://example.com").Quantity(100).Do(context.Background())
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("got %d requests, want 2", len(bodies))
+	}
+	if bodies[0] != bodies[1] {
+		t.Errorf("retry sent a different body: first=%q second=%q", bodies[0], bodies[1])
+	}
+}
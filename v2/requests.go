@@ -0,0 +1,430 @@
+package redditsmm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// BalanceRequest builds a "balance" panel call. Construct one with
+// Client.NewBalanceRequest.
+type BalanceRequest struct {
+	client *Client
+}
+
+// NewBalanceRequest starts a request for the account's balance.
+func (c *Client) NewBalanceRequest() *BalanceRequest {
+	return &BalanceRequest{client: c}
+}
+
+// Do sends the request and returns the account balance.
+func (r *BalanceRequest) Do(ctx context.Context) (UserBalance, error) {
+	values := url.Values{}
+	values.Set("key", r.client.apiKey)
+	values.Set("action", "balance")
+
+	data, err := r.client.post(ctx, values)
+	if err != nil {
+		return UserBalance{}, err
+	}
+
+	var response UserBalance
+	err = json.Unmarshal(data, &response)
+	return response, err
+}
+
+// ServicesRequest builds a "services" panel call. Construct one with
+// Client.NewServicesRequest.
+type ServicesRequest struct {
+	client *Client
+}
+
+// NewServicesRequest starts a request for the panel's service catalog.
+func (c *Client) NewServicesRequest() *ServicesRequest {
+	return &ServicesRequest{client: c}
+}
+
+// Do sends the request and returns the panel's service catalog.
+func (r *ServicesRequest) Do(ctx context.Context) ([]Service, error) {
+	values := url.Values{}
+	values.Set("key", r.client.apiKey)
+	values.Set("action", "services")
+
+	data, err := r.client.post(ctx, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []Service
+	err = json.Unmarshal(data, &response)
+	return response, err
+}
+
+// AddOrderRequest builds an "add" panel call. Construct one with
+// Client.NewAddOrderRequest.
+type AddOrderRequest struct {
+	client       *Client
+	serviceId    string
+	link         string
+	quantity     int
+	runs         *int
+	interval     *int
+	comments     []string
+	answerNumber *int
+	username     string
+}
+
+// NewAddOrderRequest starts a request to place a new order.
+func (c *Client) NewAddOrderRequest() *AddOrderRequest {
+	return &AddOrderRequest{client: c}
+}
+
+// Service sets the service ID to order.
+func (r *AddOrderRequest) Service(serviceId string) *AddOrderRequest {
+	r.serviceId = serviceId
+	return r
+}
+
+// Link sets the target link for the order.
+func (r *AddOrderRequest) Link(link string) *AddOrderRequest {
+	r.link = link
+	return r
+}
+
+// Quantity sets the order quantity.
+func (r *AddOrderRequest) Quantity(quantity int) *AddOrderRequest {
+	r.quantity = quantity
+	return r
+}
+
+// Runs sets the number of drip-feed runs.
+func (r *AddOrderRequest) Runs(runs int) *AddOrderRequest {
+	r.runs = &runs
+	return r
+}
+
+// Interval sets the drip-feed interval, in minutes, between runs.
+func (r *AddOrderRequest) Interval(interval int) *AddOrderRequest {
+	r.interval = &interval
+	return r
+}
+
+// Comments selects the "Custom Comments" order variant, sending one comment
+// per line to the panel.
+func (r *AddOrderRequest) Comments(comments []string) *AddOrderRequest {
+	r.comments = comments
+	return r
+}
+
+// AnswerNumber selects the "Poll/Vote" order variant, choosing which answer
+// to vote for.
+func (r *AddOrderRequest) AnswerNumber(answerNumber int) *AddOrderRequest {
+	r.answerNumber = &answerNumber
+	return r
+}
+
+// Username supplies the username parameter required by the "Poll/Vote"
+// order variant.
+func (r *AddOrderRequest) Username(username string) *AddOrderRequest {
+	r.username = username
+	return r
+}
+
+// Do validates the request and places the order, returning the new order ID.
+func (r *AddOrderRequest) Do(ctx context.Context) (orderId string, err error) {
+	if r.serviceId == "" {
+		return "", fmt.Errorf("redditsmm: service is required")
+	}
+	if r.link == "" {
+		return "", fmt.Errorf("redditsmm: link is required")
+	}
+	if r.quantity <= 0 {
+		return "", fmt.Errorf("redditsmm: quantity must be positive")
+	}
+
+	values := url.Values{}
+	values.Set("key", r.client.apiKey)
+	values.Set("action", "add")
+	values.Set("service", r.serviceId)
+	values.Set("link", r.link)
+	values.Set("quantity", strconv.Itoa(r.quantity))
+	if r.runs != nil {
+		values.Set("runs", strconv.Itoa(*r.runs))
+	}
+	if r.interval != nil {
+		values.Set("interval", strconv.Itoa(*r.interval))
+	}
+	if len(r.comments) > 0 {
+		values.Set("comments", strings.Join(r.comments, "\r\n"))
+	}
+	if r.answerNumber != nil {
+		values.Set("answer_number", strconv.Itoa(*r.answerNumber))
+	}
+	if r.username != "" {
+		values.Set("username", r.username)
+	}
+
+	data, err := r.client.post(ctx, values)
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		Order string `json:"order"`
+	}
+	err = json.Unmarshal(data, &response)
+	return response.Order, err
+}
+
+// StatusRequest builds a "status" panel call, for either a single order or a
+// batch of orders. Construct one with Client.NewStatusRequest.
+type StatusRequest struct {
+	client   *Client
+	orderId  string
+	orderIds []string
+}
+
+// NewStatusRequest starts a request to check order status.
+func (c *Client) NewStatusRequest() *StatusRequest {
+	return &StatusRequest{client: c}
+}
+
+// Order sets a single order ID to check. Use Do to send the request.
+func (r *StatusRequest) Order(orderId string) *StatusRequest {
+	r.orderId = orderId
+	return r
+}
+
+// Orders sets a batch of order IDs to check. Use DoMultiple to send the
+// request.
+func (r *StatusRequest) Orders(orderIds []string) *StatusRequest {
+	r.orderIds = orderIds
+	return r
+}
+
+// Do validates the request and returns the status of the single order set
+// via Order.
+func (r *StatusRequest) Do(ctx context.Context) (OrderStatus, error) {
+	if r.orderId == "" {
+		return OrderStatus{}, fmt.Errorf("redditsmm: order is required")
+	}
+
+	values := url.Values{}
+	values.Set("key", r.client.apiKey)
+	values.Set("action", "status")
+	values.Set("order", r.orderId)
+
+	data, err := r.client.post(ctx, values)
+	if err != nil {
+		return OrderStatus{}, err
+	}
+
+	var response OrderStatus
+	err = json.Unmarshal(data, &response)
+	return response, err
+}
+
+// DoMultiple validates the request and returns the status of each order set
+// via Orders, keyed by order ID.
+func (r *StatusRequest) DoMultiple(ctx context.Context) (map[string]OrderStatus, error) {
+	if len(r.orderIds) == 0 {
+		return nil, fmt.Errorf("redditsmm: orders is required")
+	}
+
+	values := url.Values{}
+	values.Set("key", r.client.apiKey)
+	values.Set("action", "status")
+	values.Set("orders", strings.Join(r.orderIds, ","))
+
+	data, err := r.client.post(ctx, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var response map[string]OrderStatus
+	err = json.Unmarshal(data, &response)
+	return response, err
+}
+
+// RefillRequest builds a "refill" panel call, for either a single order or a
+// batch of orders. Construct one with Client.NewRefillRequest.
+type RefillRequest struct {
+	client   *Client
+	orderId  string
+	orderIds []string
+}
+
+// NewRefillRequest starts a request to refill one or more orders.
+func (c *Client) NewRefillRequest() *RefillRequest {
+	return &RefillRequest{client: c}
+}
+
+// Order sets a single order ID to refill. Use Do to send the request.
+func (r *RefillRequest) Order(orderId string) *RefillRequest {
+	r.orderId = orderId
+	return r
+}
+
+// Orders sets a batch of order IDs to refill. Use DoMultiple to send the
+// request.
+func (r *RefillRequest) Orders(orderIds []string) *RefillRequest {
+	r.orderIds = orderIds
+	return r
+}
+
+// Do validates the request and refills the single order set via Order.
+func (r *RefillRequest) Do(ctx context.Context) (RefillResponse, error) {
+	if r.orderId == "" {
+		return RefillResponse{}, fmt.Errorf("redditsmm: order is required")
+	}
+
+	values := url.Values{}
+	values.Set("key", r.client.apiKey)
+	values.Set("action", "refill")
+	values.Set("order", r.orderId)
+
+	data, err := r.client.post(ctx, values)
+	if err != nil {
+		return RefillResponse{}, err
+	}
+
+	var response RefillResponse
+	err = json.Unmarshal(data, &response)
+	return response, err
+}
+
+// DoMultiple validates the request and refills the orders set via Orders,
+// keyed by order ID.
+func (r *RefillRequest) DoMultiple(ctx context.Context) (map[string]RefillResponse, error) {
+	if len(r.orderIds) == 0 {
+		return nil, fmt.Errorf("redditsmm: orders is required")
+	}
+
+	values := url.Values{}
+	values.Set("key", r.client.apiKey)
+	values.Set("action", "refill")
+	values.Set("orders", strings.Join(r.orderIds, ","))
+
+	data, err := r.client.post(ctx, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var response map[string]RefillResponse
+	err = json.Unmarshal(data, &response)
+	return response, err
+}
+
+// RefillStatusRequest builds a "refill_status" panel call, for either a
+// single refill or a batch of refills. Construct one with
+// Client.NewRefillStatusRequest.
+type RefillStatusRequest struct {
+	client    *Client
+	refillId  string
+	refillIds []string
+}
+
+// NewRefillStatusRequest starts a request to check refill status.
+func (c *Client) NewRefillStatusRequest() *RefillStatusRequest {
+	return &RefillStatusRequest{client: c}
+}
+
+// Refill sets a single refill ID to check. Use Do to send the request.
+func (r *RefillStatusRequest) Refill(refillId string) *RefillStatusRequest {
+	r.refillId = refillId
+	return r
+}
+
+// Refills sets a batch of refill IDs to check. Use DoMultiple to send the
+// request.
+func (r *RefillStatusRequest) Refills(refillIds []string) *RefillStatusRequest {
+	r.refillIds = refillIds
+	return r
+}
+
+// Do validates the request and returns the status of the single refill set
+// via Refill.
+func (r *RefillStatusRequest) Do(ctx context.Context) (RefillStatusResponse, error) {
+	if r.refillId == "" {
+		return RefillStatusResponse{}, fmt.Errorf("redditsmm: refill is required")
+	}
+
+	values := url.Values{}
+	values.Set("key", r.client.apiKey)
+	values.Set("action", "refill_status")
+	values.Set("refill", r.refillId)
+
+	data, err := r.client.post(ctx, values)
+	if err != nil {
+		return RefillStatusResponse{}, err
+	}
+
+	var response RefillStatusResponse
+	err = json.Unmarshal(data, &response)
+	return response, err
+}
+
+// DoMultiple validates the request and returns the status of each refill
+// set via Refills, keyed by refill ID.
+func (r *RefillStatusRequest) DoMultiple(ctx context.Context) (map[string]RefillStatusResponse, error) {
+	if len(r.refillIds) == 0 {
+		return nil, fmt.Errorf("redditsmm: refills is required")
+	}
+
+	values := url.Values{}
+	values.Set("key", r.client.apiKey)
+	values.Set("action", "refill_status")
+	values.Set("refills", strings.Join(r.refillIds, ","))
+
+	data, err := r.client.post(ctx, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var response map[string]RefillStatusResponse
+	err = json.Unmarshal(data, &response)
+	return response, err
+}
+
+// CancelRequest builds a "cancel" panel call for a batch of orders.
+// Construct one with Client.NewCancelRequest.
+type CancelRequest struct {
+	client   *Client
+	orderIds []string
+}
+
+// NewCancelRequest starts a request to cancel a batch of orders.
+func (c *Client) NewCancelRequest() *CancelRequest {
+	return &CancelRequest{client: c}
+}
+
+// Orders sets the order IDs to cancel.
+func (r *CancelRequest) Orders(orderIds []string) *CancelRequest {
+	r.orderIds = orderIds
+	return r
+}
+
+// Do validates the request and cancels the orders set via Orders, returning
+// the result keyed by order ID.
+func (r *CancelRequest) Do(ctx context.Context) (map[string]CancelResponse, error) {
+	if len(r.orderIds) == 0 {
+		return nil, fmt.Errorf("redditsmm: orders is required")
+	}
+
+	values := url.Values{}
+	values.Set("key", r.client.apiKey)
+	values.Set("action", "cancel")
+	values.Set("orders", strings.Join(r.orderIds, ","))
+
+	data, err := r.client.post(ctx, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var response map[string]CancelResponse
+	err = json.Unmarshal(data, &response)
+	return response, err
+}
@@ -0,0 +1,339 @@
+package redditsmm
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// maxStatusBatch is the panel's limit on how many order IDs a single
+// MultipleOrdersStatus call may request at once.
+const maxStatusBatch = 100
+
+const (
+	defaultPollInterval = 30 * time.Second
+	defaultPollJitter   = 5 * time.Second
+)
+
+// Store persists the set of order IDs a Tracker is watching, so tracking
+// survives process restarts. MemoryStore is the default; callers can plug in
+// a Redis- or Postgres-backed implementation for durability across restarts.
+type Store interface {
+	Add(ctx context.Context, orderId string) error
+	Remove(ctx context.Context, orderId string) error
+	List(ctx context.Context) ([]string, error)
+}
+
+// MemoryStore is the default, in-process Store. It does not survive
+// restarts.
+type MemoryStore struct {
+	mu       sync.Mutex
+	orderIds map[string]struct{}
+}
+
+// NewMemoryStore creates an empty in-memory Store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{orderIds: make(map[string]struct{})}
+}
+
+func (s *MemoryStore) Add(ctx context.Context, orderId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.orderIds[orderId] = struct{}{}
+	return nil
+}
+
+func (s *MemoryStore) Remove(ctx context.Context, orderId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.orderIds, orderId)
+	return nil
+}
+
+func (s *MemoryStore) List(ctx context.Context) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := make([]string, 0, len(s.orderIds))
+	for id := range s.orderIds {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// OrderEvent describes a change in a tracked order's status.
+type OrderEvent struct {
+	OrderId  string
+	Status   OrderStatus
+	Previous *OrderStatus
+}
+
+type trackerOptions struct {
+	store    Store
+	interval time.Duration
+	jitter   time.Duration
+}
+
+// TrackerOption configures a Tracker created with NewTracker.
+type TrackerOption func(*trackerOptions)
+
+// WithStore sets the Store a Tracker persists watched order IDs through. The
+// default is an in-memory Store that does not survive restarts.
+func WithStore(store Store) TrackerOption {
+	return func(o *trackerOptions) {
+		o.store = store
+	}
+}
+
+// WithPollInterval sets how often a Tracker polls for status changes.
+func WithPollInterval(interval time.Duration) TrackerOption {
+	return func(o *trackerOptions) {
+		o.interval = interval
+	}
+}
+
+// WithPollJitter sets the maximum random jitter added to each poll interval,
+// so that many Trackers polling the same panel don't all land on the same
+// tick.
+func WithPollJitter(jitter time.Duration) TrackerOption {
+	return func(o *trackerOptions) {
+		o.jitter = jitter
+	}
+}
+
+// Tracker polls MultipleOrdersStatus for a set of watched order IDs and
+// emits a transition, via Events or the registered On* callbacks, whenever
+// an order's status changes. It is the natural companion to AddOrder for
+// callers who don't want to hand-roll their own status-polling loop.
+//
+// Tracker issues its polls through the Client it was created with, so
+// polling draws from the same rate limiter as any other call made through
+// that Client and cannot starve user-initiated requests.
+type Tracker struct {
+	client   *Client
+	store    Store
+	interval time.Duration
+	jitter   time.Duration
+
+	mu       sync.Mutex
+	lastSeen map[string]OrderStatus
+
+	events chan OrderEvent
+	done   chan struct{}
+	once   sync.Once
+
+	onComplete []func(OrderEvent)
+	onPartial  []func(OrderEvent)
+	onCanceled []func(OrderEvent)
+	onError    []func(orderId string, err error)
+}
+
+// NewTracker creates a Tracker for client. Call Watch to add order IDs, then
+// Start to begin polling.
+func NewTracker(client *Client, opts ...TrackerOption) *Tracker {
+	o := &trackerOptions{
+		interval: defaultPollInterval,
+		jitter:   defaultPollJitter,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.store == nil {
+		o.store = NewMemoryStore()
+	}
+
+	return &Tracker{
+		client:   client,
+		store:    o.store,
+		interval: o.interval,
+		jitter:   o.jitter,
+		lastSeen: make(map[string]OrderStatus),
+		events:   make(chan OrderEvent),
+		done:     make(chan struct{}),
+	}
+}
+
+// Watch adds orderId to the set of orders this Tracker polls.
+func (t *Tracker) Watch(ctx context.Context, orderId string) error {
+	return t.store.Add(ctx, orderId)
+}
+
+// Unwatch removes orderId from the set of orders this Tracker polls.
+func (t *Tracker) Unwatch(ctx context.Context, orderId string) error {
+	return t.store.Remove(ctx, orderId)
+}
+
+// Events returns the channel transitions are emitted on. It is unbuffered,
+// and every transition is sent on it whether or not On* callbacks are
+// registered: the polling goroutine waits for each send to be received, or
+// for ctx to end or Stop to be called, before it handles the next status.
+// Registering On* callbacks therefore does not remove the need to read
+// Events; with no reader, only the callbacks for the first transition run.
+// The callbacks run inline on the polling goroutine.
+func (t *Tracker) Events() <-chan OrderEvent {
+	return t.events
+}
+
+// OnComplete registers a callback run whenever a watched order transitions
+// to StatusCompleted.
+func (t *Tracker) OnComplete(fn func(OrderEvent)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onComplete = append(t.onComplete, fn)
+}
+
+// OnPartial registers a callback run whenever a watched order transitions to
+// StatusPartial.
+func (t *Tracker) OnPartial(fn func(OrderEvent)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onPartial = append(t.onPartial, fn)
+}
+
+// OnCanceled registers a callback run whenever a watched order transitions
+// to StatusCanceled.
+func (t *Tracker) OnCanceled(fn func(OrderEvent)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onCanceled = append(t.onCanceled, fn)
+}
+
+// OnError registers a callback run whenever a poll fails, either for the
+// Store or for a specific order's status lookup. orderId is empty for
+// Store-level failures.
+func (t *Tracker) OnError(fn func(orderId string, err error)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.onError = append(t.onError, fn)
+}
+
+// Start begins polling in the background and returns immediately. Polling
+// stops when ctx is done or Stop is called.
+func (t *Tracker) Start(ctx context.Context) {
+	go t.run(ctx)
+}
+
+// Stop ends a running poll loop started with Start.
+func (t *Tracker) Stop() {
+	t.once.Do(func() {
+		close(t.done)
+	})
+}
+
+func (t *Tracker) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.done:
+			return
+		case <-time.After(t.interval + jitterDuration(t.jitter)):
+		}
+
+		t.poll(ctx)
+	}
+}
+
+func (t *Tracker) poll(ctx context.Context) {
+	orderIds, err := t.store.List(ctx)
+	if err != nil {
+		t.emitError("", err)
+		return
+	}
+
+	for _, batch := range chunkStrings(orderIds, maxStatusBatch) {
+		statuses, err := t.client.MultipleOrdersStatus(ctx, batch)
+		if err != nil {
+			for _, orderId := range batch {
+				t.emitError(orderId, err)
+			}
+			continue
+		}
+
+		for orderId, status := range statuses {
+			t.handleStatus(ctx, orderId, status)
+		}
+	}
+}
+
+func (t *Tracker) handleStatus(ctx context.Context, orderId string, status OrderStatus) {
+	t.mu.Lock()
+	previous, seen := t.lastSeen[orderId]
+	if seen && previous == status {
+		t.mu.Unlock()
+		return
+	}
+	t.lastSeen[orderId] = status
+	t.mu.Unlock()
+
+	event := OrderEvent{OrderId: orderId, Status: status}
+	if seen {
+		event.Previous = &previous
+	}
+
+	// Run the On* callbacks before touching the Events channel, so this
+	// transition's callbacks fire even when nobody is reading Events and the
+	// blocking send below does not hold them up.
+	t.mu.Lock()
+	switch status.Status {
+	case StatusCompleted:
+		for _, fn := range t.onComplete {
+			fn(event)
+		}
+	case StatusPartial:
+		for _, fn := range t.onPartial {
+			fn(event)
+		}
+	case StatusCanceled:
+		for _, fn := range t.onCanceled {
+			fn(event)
+		}
+	}
+	t.mu.Unlock()
+
+	// Block until the reader receives the event rather than dropping it: a
+	// non-blocking send here would silently discard any event the reader
+	// isn't synchronously ready for, and since lastSeen is already updated
+	// above, a dropped event is never resent on a later poll.
+	select {
+	case t.events <- event:
+	case <-ctx.Done():
+	case <-t.done:
+	}
+}
+
+func (t *Tracker) emitError(orderId string, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, fn := range t.onError {
+		fn(orderId, err)
+	}
+}
+
+// jitterDuration returns a random duration in [0, max). A non-positive max
+// disables jitter.
+func jitterDuration(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+// chunkStrings splits ids into batches of at most size, preserving order.
+func chunkStrings(ids []string, size int) [][]string {
+	if size <= 0 {
+		panic(fmt.Sprintf("redditsmm: invalid chunk size %d", size))
+	}
+
+	var batches [][]string
+	for len(ids) > 0 {
+		n := size
+		if n > len(ids) {
+			n = len(ids)
+		}
+		batches = append(batches, ids[:n])
+		ids = ids[n:]
+	}
+	return batches
+}
@@ -0,0 +1,177 @@
+package redditsmm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestChunkStrings(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []string
+		size int
+		want [][]string
+	}{
+		{"empty", nil, 100, nil},
+		{"under size", []string{"1", "2"}, 100, [][]string{{"1", "2"}}},
+		{"exact multiple", []string{"1", "2", "3", "4"}, 2, [][]string{{"1", "2"}, {"3", "4"}}},
+		{"remainder", []string{"1", "2", "3"}, 2, [][]string{{"1", "2"}, {"3"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := chunkStrings(tt.ids, tt.size)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("chunkStrings(%v, %d) = %v, want %v", tt.ids, tt.size, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkStringsAtPanelLimit(t *testing.T) {
+	ids := make([]string, 250)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i)
+	}
+
+	batches := chunkStrings(ids, maxStatusBatch)
+	if len(batches) != 3 {
+		t.Fatalf("got %d batches, want 3", len(batches))
+	}
+	if len(batches[0]) != 100 || len(batches[1]) != 100 || len(batches[2]) != 50 {
+		t.Errorf("unexpected batch sizes: %d, %d, %d", len(batches[0]), len(batches[1]), len(batches[2]))
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+
+	if err := s.Add(ctx, "1"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := s.Add(ctx, "2"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	ids, err := s.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	sort.Strings(ids)
+	if !reflect.DeepEqual(ids, []string{"1", "2"}) {
+		t.Errorf("List() = %v, want [1 2]", ids)
+	}
+
+	if err := s.Remove(ctx, "1"); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	ids, err = s.List(ctx)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if !reflect.DeepEqual(ids, []string{"2"}) {
+		t.Errorf("List() after Remove = %v, want [2]", ids)
+	}
+}
+
+// TestHandleStatusDedupsIdenticalState exercises handleStatus directly.
+// Stopping the tracker first closes t.done, so the (still-blocking) Events
+// send falls through immediately without needing a concurrent reader.
+func TestHandleStatusDedupsIdenticalState(t *testing.T) {
+	tracker := NewTracker(&Client{}, WithPollInterval(time.Hour))
+	tracker.Stop()
+
+	var completeCalls int
+	tracker.OnComplete(func(OrderEvent) { completeCalls++ })
+
+	ctx := context.Background()
+	completed := OrderStatus{Status: StatusCompleted}
+
+	tracker.handleStatus(ctx, "1", completed)
+	tracker.handleStatus(ctx, "1", completed)
+	if completeCalls != 1 {
+		t.Errorf("OnComplete called %d times, want 1 for identical successive transitions", completeCalls)
+	}
+
+	var partialCalls int
+	tracker.OnPartial(func(OrderEvent) { partialCalls++ })
+
+	tracker.handleStatus(ctx, "1", OrderStatus{Status: StatusPartial})
+	if partialCalls != 1 {
+		t.Errorf("OnPartial called %d times, want 1 for a genuine transition", partialCalls)
+	}
+	if completeCalls != 1 {
+		t.Errorf("OnComplete called %d times after an unrelated transition, want 1", completeCalls)
+	}
+}
+
+// TestTrackerOnCompleteWithoutReadingEvents checks that, with only
+// OnComplete registered and Events() never read, the callback for the first
+// transition still fires: callbacks run before the blocking Events send.
+func TestTrackerOnCompleteWithoutReadingEvents(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"1":{"charge":"1","start_count":"0","status":"Completed","remains":"0","currency":"USD"}}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	tracker := NewTracker(client, WithPollInterval(10*time.Millisecond), WithPollJitter(0))
+
+	completed := make(chan OrderEvent, 1)
+	tracker.OnComplete(func(e OrderEvent) { completed <- e })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := tracker.Watch(ctx, "1"); err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	tracker.Start(ctx)
+	defer tracker.Stop()
+
+	select {
+	case e := <-completed:
+		if e.OrderId != "1" || e.Status.Status != StatusCompleted {
+			t.Errorf("unexpected event: %+v", e)
+		}
+	case <-ctx.Done():
+		t.Fatal("OnComplete never fired — tracker deadlocked waiting on the unread Events channel")
+	}
+}
+
+func TestTrackerEmitsOnEventsChannel(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"1":{"charge":"1","start_count":"0","status":"Completed","remains":"0","currency":"USD"}}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	tracker := NewTracker(client, WithPollInterval(10*time.Millisecond), WithPollJitter(0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := tracker.Watch(ctx, "1"); err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	tracker.Start(ctx)
+	defer tracker.Stop()
+
+	select {
+	case e := <-tracker.Events():
+		if e.OrderId != "1" || e.Status.Status != StatusCompleted {
+			t.Errorf("unexpected event: %+v", e)
+		}
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for an event on Events()")
+	}
+}
@@ -0,0 +1,89 @@
+package redditsmm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Number wraps a float64 so callers don't each need to reimplement
+// strconv.ParseFloat against a panel API that returns numeric fields as
+// quoted strings in some responses and bare numbers in others.
+type Number float64
+
+// String renders n in the shortest form that round-trips back to the same
+// value.
+func (n Number) String() string {
+	return strconv.FormatFloat(float64(n), 'f', -1, 64)
+}
+
+// UnmarshalJSON accepts both a quoted number ("1.23") and a bare number
+// (1.23).
+func (n *Number) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*n = 0
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("redditsmm: invalid number %q: %w", s, err)
+	}
+
+	*n = Number(f)
+	return nil
+}
+
+// MarshalJSON encodes n as a bare JSON number.
+func (n Number) MarshalJSON() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// Status is the lifecycle state of an order, parsed case-insensitively from
+// the panel's status string.
+type Status string
+
+const (
+	StatusPending    Status = "Pending"
+	StatusInProgress Status = "In progress"
+	StatusCompleted  Status = "Completed"
+	StatusPartial    Status = "Partial"
+	StatusProcessing Status = "Processing"
+	StatusCanceled   Status = "Canceled"
+)
+
+// String returns the canonical panel spelling of the status.
+func (s Status) String() string {
+	return string(s)
+}
+
+// UnmarshalJSON matches the panel's status string against the known
+// statuses case-insensitively, falling back to the raw value for any status
+// this package doesn't yet know about.
+func (s *Status) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("redditsmm: invalid status: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "pending":
+		*s = StatusPending
+	case "in progress":
+		*s = StatusInProgress
+	case "completed":
+		*s = StatusCompleted
+	case "partial":
+		*s = StatusPartial
+	case "processing":
+		*s = StatusProcessing
+	case "canceled", "cancelled":
+		*s = StatusCanceled
+	default:
+		*s = Status(raw)
+	}
+
+	return nil
+}
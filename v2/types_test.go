@@ -0,0 +1,90 @@
+package redditsmm
+
+import "testing"
+
+func TestNumberUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Number
+		wantErr bool
+	}{
+		{"bare integer", `5`, 5, false},
+		{"bare float", `5.25`, 5.25, false},
+		{"quoted integer", `"5"`, 5, false},
+		{"quoted float", `"5.25"`, 5.25, false},
+		{"empty string", `""`, 0, false},
+		{"null", `null`, 0, false},
+		{"invalid", `"not-a-number"`, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n Number
+			err := n.UnmarshalJSON([]byte(tt.input))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if n != tt.want {
+				t.Fatalf("got %v, want %v", n, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumberString(t *testing.T) {
+	tests := []struct {
+		n    Number
+		want string
+	}{
+		{5, "5"},
+		{5.25, "5.25"},
+		{0, "0"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.n.String(); got != tt.want {
+			t.Errorf("Number(%v).String() = %q, want %q", float64(tt.n), got, tt.want)
+		}
+	}
+}
+
+func TestStatusUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Status
+	}{
+		{`"Pending"`, StatusPending},
+		{`"pending"`, StatusPending},
+		{`"IN PROGRESS"`, StatusInProgress},
+		{`"completed"`, StatusCompleted},
+		{`"Partial"`, StatusPartial},
+		{`"processing"`, StatusProcessing},
+		{`"Canceled"`, StatusCanceled},
+		{`"cancelled"`, StatusCanceled},
+		{`"Something Else"`, Status("Something Else")},
+	}
+
+	for _, tt := range tests {
+		var s Status
+		if err := s.UnmarshalJSON([]byte(tt.input)); err != nil {
+			t.Fatalf("unexpected error for %s: %v", tt.input, err)
+		}
+		if s != tt.want {
+			t.Errorf("UnmarshalJSON(%s) = %v, want %v", tt.input, s, tt.want)
+		}
+	}
+}
+
+func TestStatusUnmarshalJSONInvalid(t *testing.T) {
+	var s Status
+	if err := s.UnmarshalJSON([]byte(`123`)); err == nil {
+		t.Fatal("expected error for non-string status")
+	}
+}